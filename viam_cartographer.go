@@ -3,8 +3,9 @@
 package viamcartographer
 
 import (
-	"bytes"
 	"context"
+	"encoding/json"
+	"io"
 	"strconv"
 	"sync"
 	"sync/atomic"
@@ -49,26 +50,59 @@ const (
 )
 
 var defaultCartoAlgoCfg = cartofacade.CartoAlgoConfig{
-	OptimizeOnStart:      false,
-	OptimizeEveryNNodes:  3,
-	NumRangeData:         30,
-	MissingDataRayLength: 25.0,
-	MaxRange:             25.0,
-	MinRange:             0.2,
-	MaxSubmapsToKeep:     3,
-	FreshSubmapsCount:    3,
-	MinCoveredArea:       1.0,
-	MinAddedSubmapsCount: 1,
-	OccupiedSpaceWeight:  20.0,
-	TranslationWeight:    10.0,
-	RotationWeight:       1.0,
+	OptimizeOnStart:        false,
+	OptimizeEveryNNodes:    3,
+	NumRangeData:           30,
+	MissingDataRayLength:   25.0,
+	MaxRange:               25.0,
+	MinRange:               0.2,
+	MaxSubmapsToKeep:       3,
+	FreshSubmapsCount:      3,
+	MinCoveredArea:         1.0,
+	MinAddedSubmapsCount:   1,
+	OccupiedSpaceWeight:    20.0,
+	TranslationWeight:      10.0,
+	RotationWeight:         1.0,
+	UseIMUData:             false,
+	ImuGravityTimeConstant: 10.0,
+	PoseExtrapolatorType:   cartofacade.ConstantVelocityExtrapolator,
+}
+
+var defaultCartoAlgo3DCfg = cartofacade.CartoAlgoConfig{
+	OptimizeOnStart:               false,
+	OptimizeEveryNNodes:           3,
+	NumRangeData:                  30,
+	MissingDataRayLength:          25.0,
+	MaxRange:                      25.0,
+	MinRange:                      0.2,
+	MaxSubmapsToKeep:              3,
+	FreshSubmapsCount:             3,
+	MinCoveredArea:                1.0,
+	MinAddedSubmapsCount:          1,
+	OccupiedSpaceWeight:           20.0,
+	TranslationWeight:             10.0,
+	RotationWeight:                1.0,
+	HighResolutionVoxelFilterSize: 0.05,
+	LowResolutionVoxelFilterSize:  0.15,
+	MinZ:                          -1.0,
+	MaxZ:                          3.0,
+	NumRangeDataHighRes:           1,
+	NumRangeDataLowRes:            4,
+	UseIMUData:                    false,
+	ImuGravityTimeConstant:        10.0,
+	PoseExtrapolatorType:          cartofacade.ConstantVelocityExtrapolator,
 }
 
 // SubAlgo defines the cartographer specific sub-algorithms that we support.
 type SubAlgo string
 
-// Dim2d runs cartographer with a 2D LIDAR only.
-const Dim2d SubAlgo = "2d"
+const (
+	// Dim2d runs cartographer with a 2D LIDAR only.
+	Dim2d SubAlgo = "2d"
+	// Dim3d runs cartographer with a 3D LIDAR (or a 2D LIDAR paired with a tilted/vertical
+	// second LIDAR) and returns a full 3D map from GetPointCloudMap.
+	Dim3d SubAlgo = "3d"
+)
 
 func init() {
 	resource.RegisterService(slam.API, Model, resource.Registration[slam.Service, *vcConfig.Config]{
@@ -117,12 +151,15 @@ func TerminateCartoLib() error {
 
 func initSensorProcess(cancelCtx context.Context, cartoSvc *CartographerService) {
 	spConfig := sensorprocess.Config{
-		CartoFacade: cartoSvc.cartofacade,
-		Lidar:       cartoSvc.timedLidar,
-		LidarName:   cartoSvc.primarySensorName,
-		DataRateMs:  cartoSvc.dataRateMs,
-		Timeout:     cartoSvc.cartoFacadeTimeout,
-		Logger:      cartoSvc.logger,
+		CartoFacade:        cartoSvc.cartofacade,
+		Lidar:              cartoSvc.timedLidar,
+		LidarName:          cartoSvc.primarySensorName,
+		SecondaryLidar:     cartoSvc.secondaryTimedLidar,
+		SecondaryLidarName: cartoSvc.secondaryLidar.Name,
+		IMU:                cartoSvc.imu,
+		DataRateMs:         cartoSvc.dataRateMs,
+		Timeout:            cartoSvc.cartoFacadeTimeout,
+		Logger:             cartoSvc.logger,
 	}
 
 	cartoSvc.sensorProcessWorkers.Add(1)
@@ -155,11 +192,21 @@ func New(
 	}
 
 	subAlgo := SubAlgo(svcConfig.ConfigParams["mode"])
-	if subAlgo != Dim2d {
+	if subAlgo != Dim2d && subAlgo != Dim3d {
 		return nil, errors.Errorf("%v does not have a 'mode: %v'",
 			c.Model.Name, svcConfig.ConfigParams["mode"])
 	}
 
+	if svcConfig.LocalizationOnly && svcConfig.ExistingMap == "" {
+		return nil, errors.New("localization_only requires an existing_map to localize against")
+	}
+
+	wantsIMUExtrapolation := svcConfig.ConfigParams["use_imu_data"] == "true" ||
+		svcConfig.ConfigParams["pose_extrapolator"] == string(cartofacade.ImuExtrapolator)
+	if wantsIMUExtrapolation && svcConfig.IMU == "" {
+		return nil, errors.New("use_imu_data: true and pose_extrapolator: imu require an 'imu' sensor to be configured")
+	}
+
 	dataRateMsec, mapRateSec := vcConfig.GetOptionalParameters(
 		svcConfig,
 		defaultDataRateMsec,
@@ -167,12 +214,37 @@ func New(
 		logger,
 	)
 
-	// Get the lidar for the Dim2D cartographer sub algorithm
-	lidar, err := s.NewLidar(ctx, deps, svcConfig.Sensors, logger)
+	if len(svcConfig.Sensors) == 0 {
+		return nil, errors.Errorf("%v must have at least one entry in 'sensors'", c.Model.Name)
+	}
+
+	// Get the primary lidar for the configured cartographer sub algorithm. In Dim3d mode,
+	// svcConfig.Sensors may list a second (vertical/tilted) lidar or 3D range sensor which
+	// is resolved separately below and fed to cartofacade alongside the primary.
+	lidar, err := s.NewLidar(ctx, deps, svcConfig.Sensors[:1], logger)
 	if err != nil {
 		return nil, err
 	}
 
+	var secondaryLidar s.Lidar
+	hasSecondaryLidar := subAlgo == Dim3d && len(svcConfig.Sensors) > 1
+	if hasSecondaryLidar {
+		secondaryLidar, err = s.NewLidar(ctx, deps, svcConfig.Sensors[1:2], logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// An IMU is optional; when configured it is used by cartographer to extrapolate pose
+	// between lidar scans. 2D lidar-only configurations keep working unchanged.
+	var imu s.TimedIMU
+	if svcConfig.IMU != "" {
+		imu, err = s.NewIMU(ctx, deps, svcConfig.IMU, logger)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	// Need to be able to shut down the sensor process before the cartoFacade
 	cancelSensorProcessCtx, cancelSensorProcessFunc := context.WithCancel(context.Background())
 	cancelCartoFacadeCtx, cancelCartoFacadeFunc := context.WithCancel(context.Background())
@@ -185,13 +257,23 @@ func New(
 		timedSensor = lidar
 	}
 
+	var secondaryTimedLidar s.TimedSensor
+	if hasSecondaryLidar {
+		secondaryTimedLidar = secondaryLidar
+	}
+
 	// Cartographer SLAM Service Object
 	cartoSvc := &CartographerService{
 		Named:                         c.ResourceName().AsNamed(),
 		primarySensorName:             lidar.Name,
 		lidar:                         lidar,
 		timedLidar:                    timedSensor,
+		secondaryLidar:                secondaryLidar,
+		secondaryTimedLidar:           secondaryTimedLidar,
+		imu:                           imu,
 		subAlgo:                       subAlgo,
+		localizationOnly:              svcConfig.LocalizationOnly,
+		existingMap:                   svcConfig.ExistingMap,
 		configParams:                  svcConfig.ConfigParams,
 		dataDirectory:                 svcConfig.DataDirectory,
 		sensors:                       svcConfig.Sensors,
@@ -234,8 +316,22 @@ func New(
 	return cartoSvc, nil
 }
 
-func parseCartoAlgoConfig(configParams map[string]string, logger golog.Logger) (cartofacade.CartoAlgoConfig, error) {
+func parseCartoAlgoConfig(
+	subAlgo SubAlgo,
+	hasIMU bool,
+	configParams map[string]string,
+	logger golog.Logger,
+) (cartofacade.CartoAlgoConfig, error) {
 	cartoAlgoCfg := defaultCartoAlgoCfg
+	if subAlgo == Dim3d {
+		cartoAlgoCfg = defaultCartoAlgo3DCfg
+	}
+	// When an IMU is configured, default to using it for motion compensation between scans;
+	// explicit use_imu_data/pose_extrapolator config params below still take precedence.
+	if hasIMU {
+		cartoAlgoCfg.UseIMUData = true
+		cartoAlgoCfg.PoseExtrapolatorType = cartofacade.ImuExtrapolator
+	}
 	for k, val := range configParams {
 		switch k {
 		case "optimize_on_start":
@@ -314,6 +410,57 @@ func parseCartoAlgoConfig(configParams map[string]string, logger golog.Logger) (
 				return cartoAlgoCfg, err
 			}
 			cartoAlgoCfg.RotationWeight = fVal
+		case "high_resolution_voxel_filter_size":
+			fVal, err := strconv.ParseFloat(val, 32)
+			if err != nil {
+				return cartoAlgoCfg, err
+			}
+			cartoAlgoCfg.HighResolutionVoxelFilterSize = float32(fVal)
+		case "low_resolution_voxel_filter_size":
+			fVal, err := strconv.ParseFloat(val, 32)
+			if err != nil {
+				return cartoAlgoCfg, err
+			}
+			cartoAlgoCfg.LowResolutionVoxelFilterSize = float32(fVal)
+		case "min_z":
+			fVal, err := strconv.ParseFloat(val, 32)
+			if err != nil {
+				return cartoAlgoCfg, err
+			}
+			cartoAlgoCfg.MinZ = float32(fVal)
+		case "max_z":
+			fVal, err := strconv.ParseFloat(val, 32)
+			if err != nil {
+				return cartoAlgoCfg, err
+			}
+			cartoAlgoCfg.MaxZ = float32(fVal)
+		case "num_range_data_high_res":
+			iVal, err := strconv.Atoi(val)
+			if err != nil {
+				return cartoAlgoCfg, err
+			}
+			cartoAlgoCfg.NumRangeDataHighRes = iVal
+		case "num_range_data_low_res":
+			iVal, err := strconv.Atoi(val)
+			if err != nil {
+				return cartoAlgoCfg, err
+			}
+			cartoAlgoCfg.NumRangeDataLowRes = iVal
+		case "use_imu_data":
+			cartoAlgoCfg.UseIMUData = val == "true"
+		case "imu_gravity_time_constant":
+			fVal, err := strconv.ParseFloat(val, 64)
+			if err != nil {
+				return cartoAlgoCfg, err
+			}
+			cartoAlgoCfg.ImuGravityTimeConstant = fVal
+		case "pose_extrapolator":
+			switch val {
+			case string(cartofacade.ConstantVelocityExtrapolator), string(cartofacade.ImuExtrapolator):
+				cartoAlgoCfg.PoseExtrapolatorType = cartofacade.PoseExtrapolatorType(val)
+			default:
+				return cartoAlgoCfg, errors.Errorf("invalid pose_extrapolator: %s", val)
+			}
 			// ignore mode as it is a special case
 		case "mode":
 		default:
@@ -328,17 +475,24 @@ func parseCartoAlgoConfig(configParams map[string]string, logger golog.Logger) (
 // 2. initializes it and starts it
 // 3. terminates it if start fails.
 func initCartoFacade(ctx context.Context, cartoSvc *CartographerService) error {
-	cartoAlgoConfig, err := parseCartoAlgoConfig(cartoSvc.configParams, cartoSvc.logger)
+	cartoAlgoConfig, err := parseCartoAlgoConfig(cartoSvc.subAlgo, cartoSvc.imu != nil, cartoSvc.configParams, cartoSvc.logger)
 	if err != nil {
 		return err
 	}
 
+	lidarConfig := cartofacade.TwoD
+	if cartoSvc.subAlgo == Dim3d {
+		lidarConfig = cartofacade.ThreeD
+	}
+
 	cartoCfg := cartofacade.CartoConfig{
 		Sensors:            cartoSvc.sensors,
 		MapRateSecond:      cartoSvc.mapRateSec,
 		DataDir:            cartoSvc.dataDirectory,
 		ComponentReference: cartoSvc.primarySensorName,
-		LidarConfig:        cartofacade.TwoD,
+		LidarConfig:        lidarConfig,
+		LocalizationOnly:   cartoSvc.localizationOnly,
+		ExistingMap:        cartoSvc.existingMap,
 	}
 
 	cf := cartofacade.New(&cartoLib, cartoCfg, cartoAlgoConfig)
@@ -360,6 +514,7 @@ func initCartoFacade(ctx context.Context, cartoSvc *CartographerService) error {
 
 	cartoSvc.cartofacade = &cf
 	cartoSvc.SlamMode = slamMode
+	cartoSvc.cartoAlgoConfig = cartoAlgoConfig
 
 	return nil
 }
@@ -392,7 +547,18 @@ type CartographerService struct {
 	primarySensorName string
 	lidar             s.Lidar
 	timedLidar        s.TimedSensor
-	subAlgo           SubAlgo
+	// secondaryLidar and secondaryTimedLidar are only set in Dim3d mode, when a second
+	// (vertical/tilted) lidar or 3D range sensor is configured alongside the primary.
+	secondaryLidar      s.Lidar
+	secondaryTimedLidar s.TimedSensor
+	// imu is only set when the configured resource provides angular velocity and linear
+	// acceleration readings for motion compensation between scans.
+	imu     s.TimedIMU
+	subAlgo SubAlgo
+	// localizationOnly and existingMap are set when the service is configured to localize
+	// against a previously serialized map instead of starting a fresh mapping session.
+	localizationOnly bool
+	existingMap      string
 
 	configParams  map[string]string
 	dataDirectory string
@@ -400,6 +566,9 @@ type CartographerService struct {
 
 	cartofacade        cartofacade.Interface
 	cartoFacadeTimeout time.Duration
+	// cartoAlgoConfig mirrors the config last applied to cartofacade, so that get_algo_config
+	// and set_algo_config DoCommand verbs can introspect and tune it live.
+	cartoAlgoConfig cartofacade.CartoAlgoConfig
 
 	dataRateMs int
 	mapRateSec int
@@ -459,11 +628,11 @@ func (cartoSvc *CartographerService) GetPointCloudMap(ctx context.Context) (func
 		cartoSvc.mapTimestamp = time.Now().UTC()
 	}
 
-	pc, err := cartoSvc.cartofacade.GetPointCloudMap(ctx, cartoSvc.cartoFacadeTimeout)
+	pc, err := cartoSvc.cartofacade.GetPointCloudMapStream(ctx)
 	if err != nil {
 		return nil, err
 	}
-	return toChunkedFunc(pc), nil
+	return toStreamingChunkedFunc(ctx, pc, cartoSvc.logger), nil
 }
 
 // GetInternalState creates a request, calls the slam algorithms GetInternalState endpoint and returns a callback
@@ -477,25 +646,51 @@ func (cartoSvc *CartographerService) GetInternalState(ctx context.Context) (func
 		return nil, ErrClosed
 	}
 
-	is, err := cartoSvc.cartofacade.GetInternalState(ctx, cartoSvc.cartoFacadeTimeout)
+	is, err := cartoSvc.cartofacade.GetInternalStateStream(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return toChunkedFunc(is), nil
+	return toStreamingChunkedFunc(ctx, is, cartoSvc.logger), nil
 }
 
-func toChunkedFunc(b []byte) func() ([]byte, error) {
+// toStreamingChunkedFunc wraps a reader fed directly from the C++ side as the PCD/pbstream is
+// written, so a chunk is returned to the caller as soon as it is available rather than after the
+// whole map or internal state has been materialized in memory. EOF semantics match the prior
+// in-memory implementation: the final Read returning io.EOF is surfaced to the caller unchanged.
+// Per the io.Reader contract, a call may return bytesRead > 0 alongside a non-nil error; that
+// data must still reach the caller rather than being dropped on the floor.
+//
+// If the caller (e.g. a gRPC client that stops invoking the returned chunk func partway through
+// the stream) never drives r to EOF, ctx.Done() closes r so the pipe/ring-buffer feeding it isn't
+// left open for the lifetime of the process.
+func toStreamingChunkedFunc(ctx context.Context, r io.ReadCloser, logger golog.Logger) func() ([]byte, error) {
 	chunk := make([]byte, chunkSizeBytes)
 
-	reader := bytes.NewReader(b)
+	var closeOnce sync.Once
+	closeAndLogErr := func() {
+		closeOnce.Do(func() {
+			if closeErr := r.Close(); closeErr != nil {
+				logger.Warnw("error closing stream", "error", closeErr)
+			}
+		})
+	}
+
+	go func() {
+		<-ctx.Done()
+		closeAndLogErr()
+	}()
 
 	f := func() ([]byte, error) {
-		bytesRead, err := reader.Read(chunk)
+		bytesRead, err := r.Read(chunk)
+		if bytesRead > 0 {
+			return chunk[:bytesRead], nil
+		}
 		if err != nil {
+			closeAndLogErr()
 			return nil, err
 		}
-		return chunk[:bytesRead], err
+		return chunk[:bytesRead], nil
 	}
 	return f
 }
@@ -525,9 +720,186 @@ func (cartoSvc *CartographerService) DoCommand(ctx context.Context, req map[stri
 		return map[string]interface{}{"job_done": cartoSvc.jobDone.Load()}, nil
 	}
 
+	if rawPose, ok := req["relocalize"]; ok {
+		return cartoSvc.relocalize(ctx, rawPose)
+	}
+
+	if _, ok := req["get_algo_config"]; ok {
+		return cartoSvc.getAlgoConfig()
+	}
+
+	if rawUpdate, ok := req["set_algo_config"]; ok {
+		return cartoSvc.setAlgoConfig(ctx, rawUpdate)
+	}
+
+	if rawArgs, ok := req["save_state"]; ok {
+		return cartoSvc.saveState(ctx, rawArgs)
+	}
+
+	if _, ok := req["trigger_global_optimization"]; ok {
+		return cartoSvc.triggerGlobalOptimization(ctx)
+	}
+
+	if _, ok := req["get_trajectory"]; ok {
+		return cartoSvc.getTrajectory(ctx)
+	}
+
 	return nil, viamgrpc.UnimplementedError
 }
 
+// safeAlgoConfigFields are the CartoAlgoConfig fields that set_algo_config is allowed to
+// tune live. Fields that change the shape of the pose graph (e.g. NumRangeData) are excluded,
+// since changing them mid-session would leave already-inserted submaps inconsistent.
+var safeAlgoConfigFields = map[string]bool{
+	"optimize_every_n_nodes": true,
+	"max_range":              true,
+	"min_range":              true,
+	"occupied_space_weight":  true,
+	"translation_weight":     true,
+	"rotation_weight":        true,
+}
+
+// getAlgoConfig returns the CartoAlgoConfig currently applied to cartofacade as JSON.
+func (cartoSvc *CartographerService) getAlgoConfig() (map[string]interface{}, error) {
+	cartoSvc.mu.Lock()
+	defer cartoSvc.mu.Unlock()
+
+	raw, err := json.Marshal(cartoSvc.cartoAlgoConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal algo config")
+	}
+
+	var cfg map[string]interface{}
+	if err := json.Unmarshal(raw, &cfg); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal algo config")
+	}
+
+	return map[string]interface{}{"get_algo_config": cfg}, nil
+}
+
+// setAlgoConfig updates a caller-supplied subset of safeAlgoConfigFields on the running
+// cartofacade instance, rejecting any field not on the allow list.
+func (cartoSvc *CartographerService) setAlgoConfig(ctx context.Context, rawUpdate interface{}) (map[string]interface{}, error) {
+	updateMap, ok := rawUpdate.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("set_algo_config expects an object of config params, got %T", rawUpdate)
+	}
+
+	cartoSvc.mu.Lock()
+	defer cartoSvc.mu.Unlock()
+
+	updated := cartoSvc.cartoAlgoConfig
+	for k, v := range updateMap {
+		if !safeAlgoConfigFields[k] {
+			return nil, errors.Errorf("%s is not a safe field to update live", k)
+		}
+
+		fVal, ok := v.(float64)
+		if !ok {
+			return nil, errors.Errorf("%s must be a number, got %T", k, v)
+		}
+
+		switch k {
+		case "optimize_every_n_nodes":
+			updated.OptimizeEveryNNodes = int(fVal)
+		case "max_range":
+			updated.MaxRange = float32(fVal)
+		case "min_range":
+			updated.MinRange = float32(fVal)
+		case "occupied_space_weight":
+			updated.OccupiedSpaceWeight = fVal
+		case "translation_weight":
+			updated.TranslationWeight = fVal
+		case "rotation_weight":
+			updated.RotationWeight = fVal
+		}
+	}
+
+	if err := cartoSvc.cartofacade.UpdateAlgoConfig(ctx, cartoSvc.cartoFacadeTimeout, updated); err != nil {
+		return nil, errors.Wrap(err, "failed to update algo config")
+	}
+	cartoSvc.cartoAlgoConfig = updated
+
+	return map[string]interface{}{"set_algo_config": "ok"}, nil
+}
+
+// saveState triggers a serialization of the cartographer state to the caller-supplied path
+// and returns the filename it was written to.
+func (cartoSvc *CartographerService) saveState(ctx context.Context, rawArgs interface{}) (map[string]interface{}, error) {
+	argsMap, _ := rawArgs.(map[string]interface{})
+	path, ok := argsMap["path"].(string)
+	if !ok || path == "" {
+		return nil, errors.New("save_state requires a 'path' string argument")
+	}
+
+	filename, err := cartoSvc.cartofacade.SaveState(ctx, cartoSvc.cartoFacadeTimeout, path)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to save cartographer state")
+	}
+
+	return map[string]interface{}{"save_state": filename}, nil
+}
+
+// triggerGlobalOptimization forces an out-of-band optimization pass over the pose graph.
+func (cartoSvc *CartographerService) triggerGlobalOptimization(ctx context.Context) (map[string]interface{}, error) {
+	if err := cartoSvc.cartofacade.RunFinalOptimization(ctx, cartoSvc.cartoFacadeTimeout); err != nil {
+		return nil, errors.Wrap(err, "failed to trigger global optimization")
+	}
+
+	return map[string]interface{}{"trigger_global_optimization": "ok"}, nil
+}
+
+// getTrajectory returns the constraint-optimized pose history with timestamps.
+func (cartoSvc *CartographerService) getTrajectory(ctx context.Context) (map[string]interface{}, error) {
+	trajectory, err := cartoSvc.cartofacade.GetTrajectory(ctx, cartoSvc.cartoFacadeTimeout)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to get trajectory")
+	}
+
+	raw, err := json.Marshal(trajectory)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to marshal trajectory")
+	}
+
+	var trajectoryOut interface{}
+	if err := json.Unmarshal(raw, &trajectoryOut); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal trajectory")
+	}
+
+	return map[string]interface{}{"get_trajectory": trajectoryOut}, nil
+}
+
+// relocalize seeds cartographer's localization with an initial pose guess, so that a robot
+// rebooting in a previously mapped, known area does not have to wait on a global relocalization
+// search. It is only meaningful in localization_only mode, since a fresh mapping session has no
+// prior map to seed a guess against.
+func (cartoSvc *CartographerService) relocalize(ctx context.Context, rawPose interface{}) (map[string]interface{}, error) {
+	if !cartoSvc.localizationOnly {
+		return nil, errors.New("relocalize is only supported when running in localization_only mode")
+	}
+
+	poseMap, ok := rawPose.(map[string]interface{})
+	if !ok {
+		return nil, errors.Errorf("relocalize expects a pose object, got %T", rawPose)
+	}
+
+	x, _ := poseMap["x"].(float64)
+	y, _ := poseMap["y"].(float64)
+	z, _ := poseMap["z"].(float64)
+	theta, _ := poseMap["theta"].(float64)
+
+	initialPose := spatialmath.NewPose(
+		r3.Vector{X: x, Y: y, Z: z},
+		&spatialmath.OrientationVectorDegrees{Theta: theta},
+	)
+
+	if err := cartoSvc.cartofacade.SetInitialPose(ctx, cartoSvc.cartoFacadeTimeout, initialPose); err != nil {
+		return nil, errors.Wrap(err, "relocalize failed to set initial pose")
+	}
+
+	return map[string]interface{}{"relocalize": "ok"}, nil
+}
+
 // Close out of all slam related processes.
 func (cartoSvc *CartographerService) Close(ctx context.Context) error {
 	cartoSvc.mu.Lock()
@@ -0,0 +1,84 @@
+package viamcartographer
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/edaniels/golog"
+	"github.com/pkg/errors"
+	"go.viam.com/test"
+)
+
+// fakeReadCloser lets a test control exactly what a single Read call returns, including the
+// legal-but-easy-to-mishandle case of returning data and io.EOF in the same call.
+type fakeReadCloser struct {
+	reads       [][]byte
+	readErrs    []error
+	readIdx     int
+	closeCalled bool
+	closeErr    error
+}
+
+func (f *fakeReadCloser) Read(p []byte) (int, error) {
+	if f.readIdx >= len(f.reads) {
+		return 0, io.EOF
+	}
+	n := copy(p, f.reads[f.readIdx])
+	err := f.readErrs[f.readIdx]
+	f.readIdx++
+	return n, err
+}
+
+func (f *fakeReadCloser) Close() error {
+	f.closeCalled = true
+	return f.closeErr
+}
+
+func TestToStreamingChunkedFuncReturnsBytesReadAlongsideEOF(t *testing.T) {
+	r := &fakeReadCloser{
+		reads:    [][]byte{[]byte("last chunk")},
+		readErrs: []error{io.EOF},
+	}
+
+	chunkFunc := toStreamingChunkedFunc(context.Background(), r, golog.NewTestLogger(t))
+
+	b, err := chunkFunc()
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, string(b), test.ShouldEqual, "last chunk")
+
+	_, err = chunkFunc()
+	test.That(t, err, test.ShouldEqual, io.EOF)
+	test.That(t, r.closeCalled, test.ShouldBeTrue)
+}
+
+func TestToStreamingChunkedFuncClosesOnContextCancel(t *testing.T) {
+	r := &fakeReadCloser{}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	toStreamingChunkedFunc(ctx, r, golog.NewTestLogger(t))
+	cancel()
+
+	test.That(t, func() bool {
+		for i := 0; i < 1000 && !r.closeCalled; i++ {
+			// give the ctx.Done() goroutine a chance to run
+			<-time.After(time.Millisecond)
+		}
+		return r.closeCalled
+	}(), test.ShouldBeTrue)
+}
+
+func TestToStreamingChunkedFuncIgnoresCloseErrorButSurfacesReadError(t *testing.T) {
+	r := &fakeReadCloser{
+		reads:    [][]byte{{}},
+		readErrs: []error{io.EOF},
+		closeErr: errors.New("close failed"),
+	}
+
+	chunkFunc := toStreamingChunkedFunc(context.Background(), r, golog.NewTestLogger(t))
+
+	_, err := chunkFunc()
+	test.That(t, err, test.ShouldEqual, io.EOF)
+	test.That(t, r.closeCalled, test.ShouldBeTrue)
+}
@@ -0,0 +1,226 @@
+package viamcartographer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/edaniels/golog"
+	"go.viam.com/rdk/spatialmath"
+	"go.viam.com/test"
+
+	"github.com/viamrobotics/viam-cartographer/cartofacade"
+)
+
+// fakeCartoFacade embeds cartofacade.Interface so it satisfies the interface without having to
+// stub every method; only the methods exercised by a given test are overridden.
+type fakeCartoFacade struct {
+	cartofacade.Interface
+	updateAlgoConfigCalled     bool
+	setInitialPoseCalled       bool
+	saveStateCalled            bool
+	runFinalOptimizationCalled bool
+	getTrajectoryCalled        bool
+	saveStatePath              string
+	saveStateFilename          string
+	trajectory                 interface{}
+}
+
+func (f *fakeCartoFacade) UpdateAlgoConfig(ctx context.Context, timeout time.Duration, cfg cartofacade.CartoAlgoConfig) error {
+	f.updateAlgoConfigCalled = true
+	return nil
+}
+
+func (f *fakeCartoFacade) SetInitialPose(ctx context.Context, timeout time.Duration, pose spatialmath.Pose) error {
+	f.setInitialPoseCalled = true
+	return nil
+}
+
+func (f *fakeCartoFacade) SaveState(ctx context.Context, timeout time.Duration, path string) (string, error) {
+	f.saveStateCalled = true
+	f.saveStatePath = path
+	return f.saveStateFilename, nil
+}
+
+func (f *fakeCartoFacade) RunFinalOptimization(ctx context.Context, timeout time.Duration) error {
+	f.runFinalOptimizationCalled = true
+	return nil
+}
+
+func (f *fakeCartoFacade) GetTrajectory(ctx context.Context, timeout time.Duration) (interface{}, error) {
+	f.getTrajectoryCalled = true
+	return f.trajectory, nil
+}
+
+func TestSetAlgoConfigRejectsUnsafeField(t *testing.T) {
+	cartoSvc := &CartographerService{
+		logger:      golog.NewTestLogger(t),
+		cartofacade: &fakeCartoFacade{},
+	}
+
+	_, err := cartoSvc.setAlgoConfig(context.Background(), map[string]interface{}{
+		"num_range_data": 50.0,
+	})
+	test.That(t, err, test.ShouldNotBeNil)
+}
+
+func TestSetAlgoConfigRejectsNonObjectInput(t *testing.T) {
+	cartoSvc := &CartographerService{logger: golog.NewTestLogger(t)}
+
+	_, err := cartoSvc.setAlgoConfig(context.Background(), "not an object")
+	test.That(t, err, test.ShouldNotBeNil)
+}
+
+func TestSetAlgoConfigRejectsNonNumericField(t *testing.T) {
+	cartoSvc := &CartographerService{
+		logger:      golog.NewTestLogger(t),
+		cartofacade: &fakeCartoFacade{},
+	}
+
+	_, err := cartoSvc.setAlgoConfig(context.Background(), map[string]interface{}{
+		"optimize_every_n_nodes": "five",
+	})
+	test.That(t, err, test.ShouldNotBeNil)
+}
+
+func TestSetAlgoConfigAppliesSafeField(t *testing.T) {
+	fake := &fakeCartoFacade{}
+	cartoSvc := &CartographerService{
+		logger:      golog.NewTestLogger(t),
+		cartofacade: fake,
+	}
+
+	_, err := cartoSvc.setAlgoConfig(context.Background(), map[string]interface{}{
+		"optimize_every_n_nodes": 5.0,
+	})
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, fake.updateAlgoConfigCalled, test.ShouldBeTrue)
+	test.That(t, cartoSvc.cartoAlgoConfig.OptimizeEveryNNodes, test.ShouldEqual, 5)
+}
+
+func TestRelocalizeRequiresLocalizationOnly(t *testing.T) {
+	cartoSvc := &CartographerService{logger: golog.NewTestLogger(t)}
+
+	_, err := cartoSvc.relocalize(context.Background(), map[string]interface{}{"x": 1.0})
+	test.That(t, err, test.ShouldNotBeNil)
+}
+
+func TestRelocalizeRejectsMalformedPose(t *testing.T) {
+	cartoSvc := &CartographerService{
+		logger:           golog.NewTestLogger(t),
+		localizationOnly: true,
+	}
+
+	_, err := cartoSvc.relocalize(context.Background(), "not an object")
+	test.That(t, err, test.ShouldNotBeNil)
+}
+
+func TestRelocalizeSetsInitialPose(t *testing.T) {
+	fake := &fakeCartoFacade{}
+	cartoSvc := &CartographerService{
+		logger:           golog.NewTestLogger(t),
+		localizationOnly: true,
+		cartofacade:      fake,
+	}
+
+	_, err := cartoSvc.relocalize(context.Background(), map[string]interface{}{
+		"x": 1.0, "y": 2.0, "z": 0.0, "theta": 90.0,
+	})
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, fake.setInitialPoseCalled, test.ShouldBeTrue)
+}
+
+func TestGetAlgoConfigReturnsCurrentConfig(t *testing.T) {
+	cartoSvc := &CartographerService{
+		logger:          golog.NewTestLogger(t),
+		cartoAlgoConfig: defaultCartoAlgoCfg,
+	}
+
+	resp, err := cartoSvc.getAlgoConfig()
+	test.That(t, err, test.ShouldBeNil)
+	cfg, ok := resp["get_algo_config"].(map[string]interface{})
+	test.That(t, ok, test.ShouldBeTrue)
+	test.That(t, cfg["OptimizeEveryNNodes"], test.ShouldEqual, float64(defaultCartoAlgoCfg.OptimizeEveryNNodes))
+}
+
+func TestSaveStateRequiresPath(t *testing.T) {
+	cartoSvc := &CartographerService{
+		logger:      golog.NewTestLogger(t),
+		cartofacade: &fakeCartoFacade{},
+	}
+
+	_, err := cartoSvc.saveState(context.Background(), map[string]interface{}{})
+	test.That(t, err, test.ShouldNotBeNil)
+}
+
+func TestSaveStateReturnsFilename(t *testing.T) {
+	fake := &fakeCartoFacade{saveStateFilename: "map_12345.pbstream"}
+	cartoSvc := &CartographerService{
+		logger:      golog.NewTestLogger(t),
+		cartofacade: fake,
+	}
+
+	resp, err := cartoSvc.saveState(context.Background(), map[string]interface{}{"path": "/tmp/maps"})
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, fake.saveStateCalled, test.ShouldBeTrue)
+	test.That(t, fake.saveStatePath, test.ShouldEqual, "/tmp/maps")
+	test.That(t, resp["save_state"], test.ShouldEqual, "map_12345.pbstream")
+}
+
+func TestTriggerGlobalOptimization(t *testing.T) {
+	fake := &fakeCartoFacade{}
+	cartoSvc := &CartographerService{
+		logger:      golog.NewTestLogger(t),
+		cartofacade: fake,
+	}
+
+	resp, err := cartoSvc.triggerGlobalOptimization(context.Background())
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, fake.runFinalOptimizationCalled, test.ShouldBeTrue)
+	test.That(t, resp["trigger_global_optimization"], test.ShouldEqual, "ok")
+}
+
+func TestGetTrajectory(t *testing.T) {
+	fake := &fakeCartoFacade{trajectory: []map[string]interface{}{
+		{"x": 1.0, "y": 2.0, "timestamp": "2026-07-27T00:00:00Z"},
+	}}
+	cartoSvc := &CartographerService{
+		logger:      golog.NewTestLogger(t),
+		cartofacade: fake,
+	}
+
+	resp, err := cartoSvc.getTrajectory(context.Background())
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, fake.getTrajectoryCalled, test.ShouldBeTrue)
+	test.That(t, resp["get_trajectory"], test.ShouldNotBeNil)
+}
+
+func TestDoCommandDispatchesToSetAlgoConfig(t *testing.T) {
+	fake := &fakeCartoFacade{}
+	cartoSvc := &CartographerService{
+		logger:          golog.NewTestLogger(t),
+		cartofacade:     fake,
+		cartoAlgoConfig: defaultCartoAlgoCfg,
+	}
+
+	resp, err := cartoSvc.DoCommand(context.Background(), map[string]interface{}{
+		"set_algo_config": map[string]interface{}{"optimize_every_n_nodes": 7.0},
+	})
+	test.That(t, err, test.ShouldBeNil)
+	test.That(t, resp["set_algo_config"], test.ShouldEqual, "ok")
+	test.That(t, fake.updateAlgoConfigCalled, test.ShouldBeTrue)
+}
+
+func TestDoCommandReturnsUnimplementedForUnknownVerb(t *testing.T) {
+	cartoSvc := &CartographerService{logger: golog.NewTestLogger(t)}
+
+	_, err := cartoSvc.DoCommand(context.Background(), map[string]interface{}{"not_a_verb": true})
+	test.That(t, err, test.ShouldNotBeNil)
+}
+
+func TestDoCommandReturnsErrClosedAfterClose(t *testing.T) {
+	cartoSvc := &CartographerService{logger: golog.NewTestLogger(t), closed: true}
+
+	_, err := cartoSvc.DoCommand(context.Background(), map[string]interface{}{"job_done": true})
+	test.That(t, err, test.ShouldEqual, ErrClosed)
+}